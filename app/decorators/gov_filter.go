@@ -6,23 +6,26 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
-)
 
-var MiniumInitialDepositRate = sdk.NewDecWithPrec(20, 2)
+	govspamkeeper "jackfan.us.kg/oldcatv/bcna/x/govspam/keeper"
+)
 
 type GovPreventSpamDecorator struct {
-	govKeeper *govkeeper.Keeper
-	cdc       codec.BinaryCodec
+	govKeeper     *govkeeper.Keeper
+	govSpamKeeper govspamkeeper.Keeper
+	cdc           codec.BinaryCodec
 }
 
-func NewGovPreventSpamDecorator(cdc codec.BinaryCodec, govKeeper *govkeeper.Keeper) GovPreventSpamDecorator {
+func NewGovPreventSpamDecorator(cdc codec.BinaryCodec, govKeeper *govkeeper.Keeper, govSpamKeeper govspamkeeper.Keeper) GovPreventSpamDecorator {
 	return GovPreventSpamDecorator{
-		govKeeper: govKeeper,
-		cdc:       cdc,
+		govKeeper:     govKeeper,
+		govSpamKeeper: govSpamKeeper,
+		cdc:           cdc,
 	}
 }
 
@@ -49,38 +52,24 @@ func (gpsd GovPreventSpamDecorator) AnteHandle(
 func (gpsd GovPreventSpamDecorator) checkSpamSubmitProposalMsg(ctx sdk.Context, msgs []sdk.Msg) error {
 	// prevent spam gov msg
 	depositParams := gpsd.govKeeper.GetDepositParams(ctx)
-	miniumInitialDeposit := gpsd.calcMiniumInitialDeposit(depositParams.MinDeposit)
+	miniumInitialDeposit := gpsd.calcMiniumInitialDeposit(ctx, depositParams.MinDeposit)
+
+	govSpamParams := gpsd.govSpamKeeper.GetParams(ctx)
 
 	validMsg := func(m sdk.Msg) error {
 		switch msg := m.(type) {
 		case *govv1beta1.MsgSubmitProposal:
 			// // prevent spam gov msg
-
-			if msg.InitialDeposit.IsAllLT(miniumInitialDeposit) {
-				return fmt.Errorf("not enough initial deposit. required: %v: %w", miniumInitialDeposit, errors.New("insufficient funds"))
-			}
+			return checkInitialDeposit(msg.InitialDeposit, depositParams.MinDeposit, miniumInitialDeposit)
 		case *govv1.MsgSubmitProposal:
-			// don't use Gov v1 Proposals:
-			message := "- Please don't use Gov v1 Proposals in SDK v0.46! "
-			return fmt.Errorf("Failed to send a new proposal: %v: %w", message, errors.New("Not allowed"))
-		}
-
-		return nil
-	}
-
-	validAuthz := func(execMsg *authz.MsgExec) error {
-		// depositParams := gpsd.govKeeper.GetDepositParams(ctx)
-		// miniumInitialDeposit := gpsd.calcMiniumInitialDeposit(depositParams.MinDeposit)
-		for _, v := range execMsg.Msgs {
-			var innerMsg sdk.Msg
-			err := gpsd.cdc.UnpackAny(v, &innerMsg)
-			if err != nil {
-				return fmt.Errorf("not enough initial deposit. required: %v: %w", miniumInitialDeposit, errors.New("insufficient funds"))
+			if err := checkInitialDeposit(msg.InitialDeposit, depositParams.MinDeposit, miniumInitialDeposit); err != nil {
+				return err
 			}
 
-			err = validMsg(innerMsg)
-			if err != nil {
-				return err
+			for _, anyMsg := range msg.Messages {
+				if govSpamParams.IsDisabledMsgTypeURL(anyMsg.TypeUrl) {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message type %s is not allowed in gov v1 proposals", anyMsg.TypeUrl)
+				}
 			}
 		}
 
@@ -89,7 +78,7 @@ func (gpsd GovPreventSpamDecorator) checkSpamSubmitProposalMsg(ctx sdk.Context,
 
 	for _, m := range msgs {
 		if msg, ok := m.(*authz.MsgExec); ok {
-			if err := validAuthz(msg); err != nil {
+			if err := validateAuthzExec(gpsd.cdc, msg, govSpamParams.MaxAuthzExecDepth, validMsg); err != nil {
 				return err
 			}
 			continue
@@ -104,9 +93,64 @@ func (gpsd GovPreventSpamDecorator) checkSpamSubmitProposalMsg(ctx sdk.Context,
 	return nil
 }
 
-func (gpsd GovPreventSpamDecorator) calcMiniumInitialDeposit(minDeposit sdk.Coins) (miniumInitialDeposit sdk.Coins) {
+// validateAuthzExec recursively unpacks execMsg.Msgs, so a MsgSubmitProposal
+// hidden behind MsgExec(MsgExec(...)) still goes through validMsg, rejecting
+// the tx outright once nesting exceeds maxDepth. Msgs reaching here already
+// passed the fee deduction/feegrant logic earlier in the ante chain, so a
+// fee-granted tx gets the same treatment as any other - the check only ever
+// looks at tx.GetMsgs(), regardless of who pays the fee.
+func validateAuthzExec(cdc codec.BinaryCodec, execMsg *authz.MsgExec, maxDepth uint32, validMsg func(sdk.Msg) error) error {
+	return validateAuthzExecAtDepth(cdc, execMsg, maxDepth, validMsg, 1)
+}
+
+func validateAuthzExecAtDepth(cdc codec.BinaryCodec, execMsg *authz.MsgExec, maxDepth uint32, validMsg func(sdk.Msg) error, depth uint32) error {
+	if depth > maxDepth {
+		return fmt.Errorf("authz.MsgExec nesting exceeds max depth %d: %w", maxDepth, errors.New("too many nested messages"))
+	}
+
+	for _, v := range execMsg.Msgs {
+		var innerMsg sdk.Msg
+		if err := cdc.UnpackAny(v, &innerMsg); err != nil {
+			return fmt.Errorf("failed to unpack authz exec message: %w", err)
+		}
+
+		if nested, ok := innerMsg.(*authz.MsgExec); ok {
+			if err := validateAuthzExecAtDepth(cdc, nested, maxDepth, validMsg, depth+1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := validMsg(innerMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkInitialDeposit rejects any coin in initialDeposit whose denom isn't in
+// minDeposit, then requires initialDeposit to meet miniumInitialDeposit in
+// every denom (not just on average), closing the bypass where a proposer
+// padded an insufficient required denom with a large amount of another one.
+func checkInitialDeposit(initialDeposit, minDeposit, miniumInitialDeposit sdk.Coins) error {
+	for _, coin := range initialDeposit {
+		if minDeposit.AmountOf(coin.Denom).IsZero() {
+			return fmt.Errorf("denom %s is not allowed in initial deposit, only %v are accepted: %w", coin.Denom, minDeposit, errors.New("invalid deposit denom"))
+		}
+	}
+
+	if !initialDeposit.IsAllGTE(miniumInitialDeposit) {
+		return fmt.Errorf("not enough initial deposit. required: %v: %w", miniumInitialDeposit, errors.New("insufficient funds"))
+	}
+
+	return nil
+}
+
+func (gpsd GovPreventSpamDecorator) calcMiniumInitialDeposit(ctx sdk.Context, minDeposit sdk.Coins) (miniumInitialDeposit sdk.Coins) {
+	ratio := gpsd.govSpamKeeper.MinInitialDepositRatio(ctx)
 	for _, coin := range minDeposit {
-		miniumInitialCoin := MiniumInitialDepositRate.MulInt(coin.Amount).RoundInt()
+		miniumInitialCoin := ratio.MulInt(coin.Amount).RoundInt()
 		miniumInitialDeposit = miniumInitialDeposit.Add(sdk.NewCoin(coin.Denom, miniumInitialCoin))
 	}
 
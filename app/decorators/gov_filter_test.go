@@ -0,0 +1,129 @@
+package decorators
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInitialDeposit(t *testing.T) {
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	miniumInitialDeposit := sdk.NewCoins(sdk.NewInt64Coin("uatom", 20))
+
+	multiMinDeposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 100))
+	multiMiniumInitialDeposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("uatom", 20))
+
+	testCases := []struct {
+		name           string
+		initialDeposit sdk.Coins
+		minDeposit     sdk.Coins
+		minium         sdk.Coins
+		expectErr      bool
+	}{
+		{
+			name:           "single-denom sufficient",
+			initialDeposit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)),
+			minDeposit:     minDeposit,
+			minium:         miniumInitialDeposit,
+			expectErr:      false,
+		},
+		{
+			name:           "single-denom insufficient",
+			initialDeposit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 19)),
+			minDeposit:     minDeposit,
+			minium:         miniumInitialDeposit,
+			expectErr:      true,
+		},
+		{
+			name:           "multi-denom where one denom is short",
+			initialDeposit: sdk.NewCoins(sdk.NewInt64Coin("stake", 19), sdk.NewInt64Coin("uatom", 20)),
+			minDeposit:     multiMinDeposit,
+			minium:         multiMiniumInitialDeposit,
+			expectErr:      true,
+		},
+		{
+			name:           "multi-denom with an extra unrecognized denom",
+			initialDeposit: sdk.NewCoins(sdk.NewInt64Coin("spamcoin", 1_000_000), sdk.NewInt64Coin("uatom", 20)),
+			minDeposit:     minDeposit,
+			minium:         miniumInitialDeposit,
+			expectErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkInitialDeposit(tc.initialDeposit, tc.minDeposit, tc.minium)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newTestCodec() codec.BinaryCodec {
+	registry := codectypes.NewInterfaceRegistry()
+	authz.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// nestedMsgExec wraps leaf in `levels` layers of authz.MsgExec, e.g. levels=2
+// produces MsgExec{Msgs: [MsgExec{Msgs: [leaf]}]}.
+func nestedMsgExec(t *testing.T, levels int, leaf sdk.Msg) *authz.MsgExec {
+	leafAny, err := codectypes.NewAnyWithValue(leaf)
+	require.NoError(t, err)
+
+	exec := &authz.MsgExec{Grantee: "grantee", Msgs: []*codectypes.Any{leafAny}}
+	for i := 1; i < levels; i++ {
+		execAny, err := codectypes.NewAnyWithValue(exec)
+		require.NoError(t, err)
+		exec = &authz.MsgExec{Grantee: "grantee", Msgs: []*codectypes.Any{execAny}}
+	}
+
+	return exec
+}
+
+func TestValidateAuthzExec(t *testing.T) {
+	cdc := newTestCodec()
+	leaf := &banktypes.MsgSend{FromAddress: "from", ToAddress: "to", Amount: sdk.NewCoins()}
+
+	testCases := []struct {
+		name      string
+		levels    int
+		maxDepth  uint32
+		expectErr bool
+	}{
+		{name: "single level under the limit", levels: 1, maxDepth: 5, expectErr: false},
+		{name: "nested MsgExec(MsgExec(...)) under the limit", levels: 3, maxDepth: 5, expectErr: false},
+		{name: "nesting exactly at the limit", levels: 5, maxDepth: 5, expectErr: false},
+		{name: "nesting exceeding the limit is rejected", levels: 6, maxDepth: 5, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exec := nestedMsgExec(t, tc.levels, leaf)
+
+			called := false
+			validMsg := func(m sdk.Msg) error {
+				called = true
+				return nil
+			}
+
+			err := validateAuthzExec(cdc, exec, tc.maxDepth, validMsg)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.False(t, called, "validMsg must not be reached once the depth guard rejects the tx")
+			} else {
+				require.NoError(t, err)
+				require.True(t, called, "validMsg must be reached for the wrapped leaf message")
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"jackfan.us.kg/oldcatv/bcna/x/govspam/types"
+)
+
+// Keeper stores and retrieves the govspam module's parameters from its own
+// store, gated by the authority address (the gov module account by default).
+type Keeper struct {
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	authority string
+}
+
+// NewKeeper creates a new govspam Keeper instance. authority is the address
+// permitted to execute MsgUpdateParams, typically the gov module account.
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the address authorized to update the module's params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
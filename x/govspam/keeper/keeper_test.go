@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"jackfan.us.kg/oldcatv/bcna/x/govspam/keeper"
+	"jackfan.us.kg/oldcatv/bcna/x/govspam/types"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	return keeper.NewKeeper(cdc, storeKey, authority), ctx
+}
+
+func TestGetParamsDefault(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestSetParamsRejectsInvalidRatio(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	invalid := types.NewParams(sdk.NewDec(2), nil, types.DefaultMaxAuthzExecDepth)
+	require.Error(t, k.SetParams(ctx, invalid))
+
+	// the invalid params must not have been persisted
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestSetParamsPersists(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	updated := types.NewParams(sdk.NewDecWithPrec(50, 2), []string{"/bcna.govspam.v1.MsgUpdateParams"}, 3)
+	require.NoError(t, k.SetParams(ctx, updated))
+	require.Equal(t, updated, k.GetParams(ctx))
+}
+
+func TestMsgServerUpdateParamsRejectsWrongAuthority(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: "not-the-authority",
+		Params:    types.DefaultParams(),
+	})
+	require.Error(t, err)
+}
+
+func TestMsgServerUpdateParamsAcceptsAuthority(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	newParams := types.NewParams(sdk.NewDecWithPrec(50, 2), nil, types.DefaultMaxAuthzExecDepth)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    newParams,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newParams, k.GetParams(ctx))
+}
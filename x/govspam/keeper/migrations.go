@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"jackfan.us.kg/oldcatv/bcna/x/govspam/types"
+)
+
+// Migrator is a wrapper around the govspam Keeper exposing versioned store
+// migrations for the module manager.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator for the govspam module.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 seeds the module's store with DefaultParams.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
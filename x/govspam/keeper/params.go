@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"jackfan.us.kg/oldcatv/bcna/x/govspam/types"
+)
+
+// GetParams returns the govspam module's current parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the govspam module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// MinInitialDepositRatio is a convenience accessor for GetParams(ctx).MinInitialDepositRatio.
+func (k Keeper) MinInitialDepositRatio(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinInitialDepositRatio
+}
+
+// MaxAuthzExecDepth is a convenience accessor for GetParams(ctx).MaxAuthzExecDepth.
+func (k Keeper) MaxAuthzExecDepth(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxAuthzExecDepth
+}
@@ -0,0 +1,16 @@
+package types
+
+// NewGenesisState creates a new genesis state for the govspam module.
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{Params: params}
+}
+
+// DefaultGenesisState returns the default govspam genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName is the name of the govspam module.
+	ModuleName = "govspam"
+
+	// StoreKey is the store key string for the govspam module.
+	StoreKey = ModuleName
+)
+
+// ParamsKey is the key under which the module's Params are stored.
+var ParamsKey = []byte{0x01}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMinInitialDepositRatio is the ratio used to seed Params when the
+// module is first installed, matching the rate GovPreventSpamDecorator used
+// to hard-code.
+var DefaultMinInitialDepositRatio = sdk.NewDecWithPrec(20, 2)
+
+// DefaultMaxAuthzExecDepth is the nested authz.MsgExec unpacking limit used
+// to seed Params when the module is first installed.
+const DefaultMaxAuthzExecDepth = 5
+
+// NewParams creates a new Params instance.
+func NewParams(minInitialDepositRatio sdk.Dec, disabledMsgTypeURLs []string, maxAuthzExecDepth uint32) Params {
+	return Params{
+		MinInitialDepositRatio: minInitialDepositRatio,
+		DisabledMsgTypeUrls:    disabledMsgTypeURLs,
+		MaxAuthzExecDepth:      maxAuthzExecDepth,
+	}
+}
+
+// DefaultParams returns the default govspam module parameters. No inner
+// message types are disabled by default.
+func DefaultParams() Params {
+	return NewParams(DefaultMinInitialDepositRatio, nil, DefaultMaxAuthzExecDepth)
+}
+
+// IsDisabledMsgTypeURL reports whether typeURL is on the module's
+// disabled-message-type list.
+func (p Params) IsDisabledMsgTypeURL(typeURL string) bool {
+	for _, denied := range p.DisabledMsgTypeUrls {
+		if denied == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs basic validation of the govspam parameters.
+func (p Params) Validate() error {
+	if p.MinInitialDepositRatio.IsNil() {
+		return fmt.Errorf("min initial deposit ratio cannot be nil")
+	}
+	if p.MinInitialDepositRatio.IsNegative() {
+		return fmt.Errorf("min initial deposit ratio cannot be negative: %s", p.MinInitialDepositRatio)
+	}
+	if p.MinInitialDepositRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("min initial deposit ratio cannot be greater than 1: %s", p.MinInitialDepositRatio)
+	}
+	if p.MaxAuthzExecDepth == 0 {
+		return fmt.Errorf("max authz exec depth must be greater than 0")
+	}
+	return nil
+}
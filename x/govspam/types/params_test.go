@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"jackfan.us.kg/oldcatv/bcna/x/govspam/types"
+)
+
+func TestIsDisabledMsgTypeURL(t *testing.T) {
+	params := types.NewParams(types.DefaultMinInitialDepositRatio, []string{"/bcna.govspam.v1.MsgUpdateParams"}, types.DefaultMaxAuthzExecDepth)
+
+	require.True(t, params.IsDisabledMsgTypeURL("/bcna.govspam.v1.MsgUpdateParams"))
+	require.False(t, params.IsDisabledMsgTypeURL("/cosmos.gov.v1.MsgUpdateParams"))
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expectErr bool
+	}{
+		{
+			name:      "default params",
+			params:    types.DefaultParams(),
+			expectErr: false,
+		},
+		{
+			name:      "nil ratio",
+			params:    types.NewParams(sdk.Dec{}, nil, types.DefaultMaxAuthzExecDepth),
+			expectErr: true,
+		},
+		{
+			name:      "negative ratio",
+			params:    types.NewParams(sdk.NewDec(-1), nil, types.DefaultMaxAuthzExecDepth),
+			expectErr: true,
+		},
+		{
+			name:      "ratio greater than one",
+			params:    types.NewParams(sdk.NewDec(2), nil, types.DefaultMaxAuthzExecDepth),
+			expectErr: true,
+		},
+		{
+			name:      "zero max authz exec depth",
+			params:    types.NewParams(types.DefaultMinInitialDepositRatio, nil, 0),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}